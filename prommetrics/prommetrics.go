@@ -0,0 +1,98 @@
+// Package prommetrics provides a Prometheus implementation of awscreds.Metrics.
+package prommetrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// New creates a Metrics and registers its collectors with reg.
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		attempts: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "awscreds_refresh_attempts_total",
+			Help: "Total number of credentials refresh attempts.",
+		}),
+		successes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "awscreds_refresh_successes_total",
+			Help: "Total number of successful credentials refreshes.",
+		}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "awscreds_refresh_failures_total",
+			Help: "Total number of failed credentials refreshes.",
+		}),
+		swaps: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "awscreds_credentials_swapped_total",
+			Help: "Total number of times cached credentials were replaced with new ones.",
+		}),
+		signers: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "awscreds_signer_attached_total",
+			Help: "Total number of times a request signer was attached to credentials.",
+		}),
+		latency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "awscreds_refresh_duration_seconds",
+			Help:    "Duration of successful credentials refreshes.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		expiry: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "awscreds_seconds_until_expiry",
+			Help: "Seconds until the cached credentials expire, as of the last ObserveExpiry call.",
+		}),
+	}
+
+	reg.MustRegister(m.attempts, m.successes, m.failures, m.swaps, m.signers, m.latency, m.expiry)
+	return m
+}
+
+// Metrics is a Prometheus-backed implementation of awscreds.Metrics, wired up
+// via awscreds.WithMetrics.
+type Metrics struct {
+	attempts  prometheus.Counter
+	successes prometheus.Counter
+	failures  prometheus.Counter
+	swaps     prometheus.Counter
+	signers   prometheus.Counter
+	latency   prometheus.Histogram
+	expiry    prometheus.Gauge
+}
+
+// RefreshAttempted implements awscreds.Metrics.
+func (m *Metrics) RefreshAttempted() {
+	m.attempts.Inc()
+}
+
+// RefreshSucceeded implements awscreds.Metrics.
+func (m *Metrics) RefreshSucceeded(d time.Duration) {
+	m.successes.Inc()
+	m.latency.Observe(d.Seconds())
+}
+
+// RefreshFailed implements awscreds.Metrics.
+func (m *Metrics) RefreshFailed(err error) {
+	m.failures.Inc()
+}
+
+// CredentialsSwapped implements awscreds.Metrics.
+func (m *Metrics) CredentialsSwapped() {
+	m.swaps.Inc()
+}
+
+// SignerAttached implements awscreds.Metrics.
+func (m *Metrics) SignerAttached() {
+	m.signers.Inc()
+}
+
+// ObserveExpiry sets the seconds-until-expiry gauge from expiresAt. Wire it
+// up to awscreds.Refresher/Swapper's Subscribe channel, e.g.:
+//
+//	ch, cancel := s.Subscribe()
+//	go func() {
+//		for creds := range ch {
+//			expiresAt, _ := creds.ExpiresAt()
+//			m.ObserveExpiry(expiresAt)
+//		}
+//	}()
+func (m *Metrics) ObserveExpiry(expiresAt time.Time) {
+	m.expiry.Set(time.Until(expiresAt).Seconds())
+}
@@ -0,0 +1,44 @@
+package prommetrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetricsIncrementCounters(t *testing.T) {
+	m := New(prometheus.NewRegistry())
+
+	m.RefreshAttempted()
+	m.RefreshSucceeded(100 * time.Millisecond)
+	m.RefreshFailed(errors.New("boom"))
+	m.CredentialsSwapped()
+	m.SignerAttached()
+
+	for name, c := range map[string]prometheus.Counter{
+		"attempts":  m.attempts,
+		"successes": m.successes,
+		"failures":  m.failures,
+		"swaps":     m.swaps,
+		"signers":   m.signers,
+	} {
+		if got := testutil.ToFloat64(c); got != 1 {
+			t.Errorf("%s = %v, want 1", name, got)
+		}
+	}
+}
+
+func TestMetricsObserveExpiry(t *testing.T) {
+	m := New(prometheus.NewRegistry())
+
+	m.ObserveExpiry(time.Now().Add(time.Hour))
+
+	got := testutil.ToFloat64(m.expiry)
+	want := time.Hour.Seconds()
+	if got < want-5 || got > want+5 {
+		t.Errorf("expiry gauge = %v, want ~%v", got, want)
+	}
+}
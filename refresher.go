@@ -3,6 +3,7 @@ package awscreds
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -25,8 +26,9 @@ func NewRefresher(creds *credentials.Credentials, opts ...Option) (*Refresher, e
 
 	r := Refresher{
 		conf: Config{
-			logger: log.NewNopLogger(),
-			period: 55 * time.Minute,
+			logger:  log.NewNopLogger(),
+			period:  55 * time.Minute,
+			metrics: noopMetrics{},
 		},
 		creds: creds,
 	}
@@ -34,7 +36,7 @@ func NewRefresher(creds *credentials.Credentials, opts ...Option) (*Refresher, e
 		opt(&r.conf)
 	}
 
-	if _, err := creds.Get(); err != nil {
+	if err := r.refresh(); err != nil {
 		return nil, fmt.Errorf("failed to retrieve credentials value: %w", err)
 	}
 	return &r, nil
@@ -44,6 +46,42 @@ func NewRefresher(creds *credentials.Credentials, opts ...Option) (*Refresher, e
 type Refresher struct {
 	conf  Config
 	creds *credentials.Credentials
+
+	mu          sync.Mutex
+	lastErr     error
+	lastRefresh time.Time
+
+	lastValue credentials.Value
+	subs      subscribers
+}
+
+// refresh fetches a fresh credentials value and records the outcome so it's
+// visible via Healthy and LastRefresh. Subscribers are only notified when
+// the value actually rotated, since Get() is a cache hit on most ticks.
+func (r *Refresher) refresh() error {
+	r.conf.metrics.RefreshAttempted()
+	start := time.Now()
+
+	v, err := r.creds.Get()
+
+	r.mu.Lock()
+	r.lastErr = err
+	if err == nil {
+		r.lastRefresh = time.Now()
+	}
+	r.mu.Unlock()
+
+	if err != nil {
+		r.conf.metrics.RefreshFailed(err)
+		return err
+	}
+
+	r.conf.metrics.RefreshSucceeded(time.Since(start))
+	if v != r.lastValue {
+		r.lastValue = v
+		r.subs.notify(r.creds)
+	}
+	return nil
 }
 
 // Run periodically fetches the credentials to keep them always active.
@@ -54,10 +92,44 @@ func (r *Refresher) Run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 
-		case <-time.After(r.conf.period):
-			if _, err := r.creds.Get(); err != nil {
-				r.conf.logger.Log("msg", "failed to refresh aws credentials", "err", err)
-			}
+		case <-time.After(r.conf.nextInterval(r.creds)):
+			r.conf.retryRefresh(ctx, r.refresh)
 		}
 	}
 }
+
+// Healthy reports whether the last refresh succeeded and the currently held
+// credentials are not expired, along with the last refresh error if any.
+// It's meant to back a Kubernetes readiness/liveness probe.
+func (r *Refresher) Healthy() (bool, error) {
+	r.mu.Lock()
+	err := r.lastErr
+	r.mu.Unlock()
+
+	if err != nil {
+		return false, err
+	}
+	return !r.creds.IsExpired(), nil
+}
+
+// LastRefresh returns the time of the last successful refresh.
+func (r *Refresher) LastRefresh() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastRefresh
+}
+
+// ExpiresAt returns the expiration time of the currently held credentials.
+func (r *Refresher) ExpiresAt() time.Time {
+	t, _ := r.creds.ExpiresAt()
+	return t
+}
+
+// Subscribe returns a channel receiving the credentials every time they
+// actually rotate, and a cancel func to unregister. The current credentials
+// are sent immediately so late subscribers don't wait for the next
+// rotation. Delivery is non-blocking: a slow subscriber only ever sees the
+// newest value.
+func (r *Refresher) Subscribe() (<-chan *credentials.Credentials, func()) {
+	return r.subs.add(r.creds)
+}
@@ -0,0 +1,118 @@
+package awscreds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// fakeFlakyProvider is a credentials.Provider backed by a closure, with an
+// expired flag tests can flip to force Get() to call Retrieve again.
+type fakeFlakyProvider struct {
+	calls    int
+	retrieve func(call int) (credentials.Value, error)
+	expired  bool
+}
+
+func (p *fakeFlakyProvider) Retrieve() (credentials.Value, error) {
+	p.calls++
+	return p.retrieve(p.calls)
+}
+
+func (p *fakeFlakyProvider) IsExpired() bool {
+	return p.expired
+}
+
+func TestRefresherHealthyReflectsFailedRefresh(t *testing.T) {
+	provider := &fakeFlakyProvider{
+		retrieve: func(call int) (credentials.Value, error) {
+			if call > 1 {
+				return credentials.Value{}, errors.New("sts unavailable")
+			}
+			return credentials.Value{AccessKeyID: "AKIDTEST"}, nil
+		},
+	}
+	creds := credentials.NewCredentials(provider)
+
+	r, err := NewRefresher(creds)
+	if err != nil {
+		t.Fatalf("NewRefresher() returned an error: %v", err)
+	}
+
+	if healthy, err := r.Healthy(); err != nil || !healthy {
+		t.Fatalf("Healthy() = (%v, %v), want (true, nil) right after construction", healthy, err)
+	}
+
+	provider.expired = true
+	if err := r.refresh(); err == nil {
+		t.Fatal("refresh() succeeded unexpectedly on the second call")
+	}
+
+	healthy, err := r.Healthy()
+	if healthy {
+		t.Error("Healthy() = true, want false after a failed refresh")
+	}
+	if err == nil {
+		t.Error("Healthy() returned a nil error after a failed refresh")
+	}
+}
+
+func TestRefresherLastRefreshExpiresAt(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	creds := newTestCreds(t, expiresAt)
+
+	r, err := NewRefresher(creds)
+	if err != nil {
+		t.Fatalf("NewRefresher() returned an error: %v", err)
+	}
+
+	if got := r.LastRefresh(); got.IsZero() {
+		t.Error("LastRefresh() is zero, want a recent timestamp")
+	}
+
+	if got := r.ExpiresAt(); !got.Equal(expiresAt) {
+		t.Errorf("ExpiresAt() = %v, want %v", got, expiresAt)
+	}
+}
+
+func TestRefresherSubscribeReceivesRotation(t *testing.T) {
+	provider := &fakeFlakyProvider{
+		retrieve: func(call int) (credentials.Value, error) {
+			return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: time.Duration(call).String()}, nil
+		},
+	}
+	creds := credentials.NewCredentials(provider)
+
+	r, err := NewRefresher(creds)
+	if err != nil {
+		t.Fatalf("NewRefresher() returned an error: %v", err)
+	}
+
+	ch, cancel := r.Subscribe()
+	defer cancel()
+
+	select {
+	case got := <-ch:
+		if got != creds {
+			t.Errorf("got %v, want seeded credentials %v", got, creds)
+		}
+	default:
+		t.Fatal("expected the current credentials to be seeded immediately")
+	}
+
+	provider.expired = true
+	if err := r.refresh(); err != nil {
+		t.Fatalf("refresh() returned an error: %v", err)
+	}
+
+	select {
+	case got := <-ch:
+		if got != creds {
+			t.Errorf("got %v, want rotated credentials %v", got, creds)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a value after refresh rotated the credentials value")
+	}
+}
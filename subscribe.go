@@ -0,0 +1,64 @@
+package awscreds
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// subscribers tracks channels to notify when credentials rotate. Deliveries
+// are non-blocking: a slow subscriber's buffered value is dropped in favor
+// of the newest one rather than stalling the refresh loop.
+type subscribers struct {
+	mu     sync.Mutex
+	nextID int
+	chans  map[int]chan *credentials.Credentials
+}
+
+// add registers a new subscriber channel, seeded with current if non-nil so
+// late subscribers don't have to wait a full period for the first value, and
+// returns it along with a cancel func to unregister it.
+func (s *subscribers) add(current *credentials.Credentials) (<-chan *credentials.Credentials, func()) {
+	ch := make(chan *credentials.Credentials, 1)
+	if current != nil {
+		ch <- current
+	}
+
+	s.mu.Lock()
+	if s.chans == nil {
+		s.chans = make(map[int]chan *credentials.Credentials)
+	}
+	id := s.nextID
+	s.nextID++
+	s.chans[id] = ch
+	s.mu.Unlock()
+
+	cancel := func() {
+		s.mu.Lock()
+		delete(s.chans, id)
+		s.mu.Unlock()
+	}
+	return ch, cancel
+}
+
+// notify delivers creds to every subscriber without blocking, dropping a
+// previously buffered, undrained value in favor of creds.
+func (s *subscribers) notify(creds *credentials.Credentials) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ch := range s.chans {
+		select {
+		case ch <- creds:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- creds:
+			default:
+			}
+		}
+	}
+}
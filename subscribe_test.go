@@ -0,0 +1,77 @@
+package awscreds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestSubscribersAddSeedsCurrentValue(t *testing.T) {
+	var s subscribers
+	want := credentials.NewStaticCredentials("AKID", "SECRET", "")
+
+	ch, cancel := s.add(want)
+	defer cancel()
+
+	select {
+	case got := <-ch:
+		if got != want {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	default:
+		t.Fatal("expected the current value to be seeded immediately")
+	}
+}
+
+func TestSubscribersAddWithNilCurrentDoesNotSeed(t *testing.T) {
+	var s subscribers
+	ch, cancel := s.add(nil)
+	defer cancel()
+
+	select {
+	case got := <-ch:
+		t.Fatalf("expected no seeded value, got %v", got)
+	default:
+	}
+}
+
+func TestSubscribersNotifyDropsOldestUndrainedValue(t *testing.T) {
+	var s subscribers
+	ch, cancel := s.add(nil)
+	defer cancel()
+
+	first := credentials.NewStaticCredentials("AKID1", "SECRET1", "")
+	second := credentials.NewStaticCredentials("AKID2", "SECRET2", "")
+
+	s.notify(first)
+	s.notify(second)
+
+	select {
+	case got := <-ch:
+		if got != second {
+			t.Errorf("got %v, want newest value %v (oldest should have been dropped)", got, second)
+		}
+	default:
+		t.Fatal("expected a value to be delivered")
+	}
+
+	select {
+	case got := <-ch:
+		t.Fatalf("expected channel to be empty after draining one value, got %v", got)
+	default:
+	}
+}
+
+func TestSubscribersCancelUnregisters(t *testing.T) {
+	var s subscribers
+	ch, cancel := s.add(nil)
+	cancel()
+
+	s.notify(credentials.NewStaticCredentials("AKID", "SECRET", ""))
+
+	select {
+	case got := <-ch:
+		t.Fatalf("expected no delivery after cancel, got %v", got)
+	default:
+	}
+}
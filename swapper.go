@@ -5,6 +5,7 @@ package awscreds
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -49,8 +50,9 @@ func NewSwapper(newcreds NewCreds, opts ...Option) (*Swapper, error) {
 
 	s := Swapper{
 		conf: Config{
-			logger: log.NewNopLogger(),
-			period: 55 * time.Minute,
+			logger:  log.NewNopLogger(),
+			period:  55 * time.Minute,
+			metrics: noopMetrics{},
 		},
 		newcreds: newcreds,
 	}
@@ -69,10 +71,40 @@ type Swapper struct {
 	conf     Config
 	newcreds func() (*credentials.Credentials, error)
 	cache    atomic.Value
+
+	mu          sync.Mutex
+	lastErr     error
+	lastRefresh time.Time
+
+	subs subscribers
 }
 
-// refresh refreshes cached credentials.
+// refresh refreshes cached credentials and records the outcome so it's
+// visible via Healthy and LastRefresh.
 func (s *Swapper) refresh() error {
+	s.conf.metrics.RefreshAttempted()
+	start := time.Now()
+
+	err := s.swap()
+
+	s.mu.Lock()
+	s.lastErr = err
+	if err == nil {
+		s.lastRefresh = time.Now()
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		s.conf.metrics.RefreshFailed(err)
+		return err
+	}
+
+	s.conf.metrics.RefreshSucceeded(time.Since(start))
+	return nil
+}
+
+// swap fetches new credentials and, on success, stores them in the cache.
+func (s *Swapper) swap() error {
 	creds, err := s.newcreds()
 	if err != nil {
 		return fmt.Errorf("failed to create new credentials: %w", err)
@@ -82,6 +114,8 @@ func (s *Swapper) refresh() error {
 	}
 
 	s.cache.Store(creds)
+	s.conf.metrics.CredentialsSwapped()
+	s.subs.notify(creds)
 	return nil
 }
 
@@ -94,14 +128,75 @@ func (s *Swapper) Run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 
-		case <-time.After(s.conf.period):
-			if err := s.refresh(); err != nil {
-				s.conf.logger.Log("msg", "failed to refresh aws credentials", "err", err)
-			}
+		case <-time.After(s.nextInterval()):
+			s.conf.retryRefresh(ctx, s.refresh)
 		}
 	}
 }
 
+// nextInterval returns the sleep duration before the next refresh attempt,
+// based on the currently cached credentials' expiry, see Config.nextInterval.
+func (s *Swapper) nextInterval() time.Duration {
+	v := s.cache.Load()
+	creds, ok := v.(*credentials.Credentials)
+	if !ok || creds == nil {
+		return s.conf.period
+	}
+	return s.conf.nextInterval(creds)
+}
+
+// Healthy reports whether the last refresh succeeded and the currently
+// cached credentials are non-nil and not expired, along with the last
+// refresh error if any. It's meant to back a Kubernetes readiness/liveness
+// probe.
+func (s *Swapper) Healthy() (bool, error) {
+	s.mu.Lock()
+	err := s.lastErr
+	s.mu.Unlock()
+
+	if err != nil {
+		return false, err
+	}
+
+	v := s.cache.Load()
+	creds, ok := v.(*credentials.Credentials)
+	if !ok || creds == nil {
+		return false, fmt.Errorf("no cached credentials")
+	}
+	return !creds.IsExpired(), nil
+}
+
+// LastRefresh returns the time of the last successful refresh.
+func (s *Swapper) LastRefresh() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastRefresh
+}
+
+// ExpiresAt returns the expiration time of the currently cached credentials.
+func (s *Swapper) ExpiresAt() time.Time {
+	v := s.cache.Load()
+	creds, ok := v.(*credentials.Credentials)
+	if !ok || creds == nil {
+		return time.Time{}
+	}
+
+	t, _ := creds.ExpiresAt()
+	return t
+}
+
+// Subscribe returns a channel receiving the credentials every time a refresh
+// succeeds, and a cancel func to unregister. The current cached credentials
+// are sent immediately so late subscribers don't wait a full period.
+// Delivery is non-blocking: a slow subscriber only ever sees the newest
+// value. This lets callers holding long-lived custom signers (e.g. MSK/
+// OpenSearch/Kafka producers) react to rotation instead of polling.
+func (s *Swapper) Subscribe() (<-chan *credentials.Credentials, func()) {
+	v := s.cache.Load()
+	creds, _ := v.(*credentials.Credentials)
+	return s.subs.add(creds)
+}
+
 // Attach allows to tune a request signer of the AWS client with opts functions.
 // They are called each time a signer is constructed just before signing a request.
 // For example, you can change a signer's flags or its credentials fetcher.
@@ -115,18 +210,28 @@ func (s *Swapper) Run(ctx context.Context) {
 // https://github.com/aws/aws-sdk-go/blob/6a228d939132a3159d0dce221879efbedd842d61/aws/signer/v4/v4.go#L469,
 // so that particular S3 option is redundant.
 func (s *Swapper) Attach(c *client.Client, opts ...func(*v4.Signer)) bool {
-	opts = append(opts, func(signer *v4.Signer) {
-		v := s.cache.Load()
-		creds, ok := v.(*credentials.Credentials)
-		if creds != nil && ok {
-			signer.Credentials = creds
-		} else {
-			s.conf.logger.Log("msg", "failed to swap aws credentials")
-		}
-	})
+	opts = append(opts, s.tuneSigner)
 
 	return c.Handlers.Sign.SwapNamed(v4.BuildNamedHandler(
 		v4.SignRequestHandler.Name,
 		opts...,
 	))
 }
+
+// tuneSigner swaps in the cached credentials on signer, refusing to do so
+// (leaving signer.Credentials untouched) if there's nothing cached yet, or
+// if WithFailIfExpired is set and the cached credentials have expired.
+func (s *Swapper) tuneSigner(signer *v4.Signer) {
+	s.conf.metrics.SignerAttached()
+
+	v := s.cache.Load()
+	creds, ok := v.(*credentials.Credentials)
+	switch {
+	case creds == nil || !ok:
+		s.conf.logger.Log("msg", "failed to swap aws credentials")
+	case s.conf.failIfExpired && creds.IsExpired():
+		s.conf.logger.Log("msg", "refusing to sign with expired aws credentials")
+	default:
+		signer.Credentials = creds
+	}
+}
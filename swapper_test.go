@@ -0,0 +1,146 @@
+package awscreds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+)
+
+func newExpiredTestCreds(t *testing.T) *credentials.Credentials {
+	t.Helper()
+
+	creds := credentials.NewCredentials(&fakeExpirerProvider{expired: true})
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("creds.Get() returned an error: %v", err)
+	}
+	return creds
+}
+
+func TestSwapperHealthyLastRefreshExpiresAt(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	valid := newTestCreds(t, expiresAt)
+
+	s, err := NewSwapper(func() (*credentials.Credentials, error) { return valid, nil })
+	if err != nil {
+		t.Fatalf("NewSwapper() returned an error: %v", err)
+	}
+
+	if healthy, err := s.Healthy(); err != nil || !healthy {
+		t.Fatalf("Healthy() = (%v, %v), want (true, nil) right after construction", healthy, err)
+	}
+
+	if got := s.LastRefresh(); got.IsZero() {
+		t.Error("LastRefresh() is zero, want a recent timestamp")
+	}
+
+	if got := s.ExpiresAt(); !got.Equal(expiresAt) {
+		t.Errorf("ExpiresAt() = %v, want %v", got, expiresAt)
+	}
+}
+
+func TestSwapperHealthyReflectsFailedRefresh(t *testing.T) {
+	calls := 0
+	newcreds := func() (*credentials.Credentials, error) {
+		calls++
+		if calls > 1 {
+			return nil, errors.New("sts unavailable")
+		}
+		return newTestCreds(t, time.Now().Add(time.Hour)), nil
+	}
+
+	s, err := NewSwapper(newcreds)
+	if err != nil {
+		t.Fatalf("NewSwapper() returned an error: %v", err)
+	}
+
+	if err := s.refresh(); err == nil {
+		t.Fatal("refresh() succeeded unexpectedly on the second call")
+	}
+
+	healthy, err := s.Healthy()
+	if healthy {
+		t.Error("Healthy() = true, want false after a failed refresh")
+	}
+	if err == nil {
+		t.Error("Healthy() returned a nil error after a failed refresh")
+	}
+}
+
+func TestSwapperSubscribeReceivesRotation(t *testing.T) {
+	first := newTestCreds(t, time.Now().Add(time.Hour))
+	second := newTestCreds(t, time.Now().Add(2*time.Hour))
+
+	calls := 0
+	newcreds := func() (*credentials.Credentials, error) {
+		calls++
+		if calls == 1 {
+			return first, nil
+		}
+		return second, nil
+	}
+
+	s, err := NewSwapper(newcreds)
+	if err != nil {
+		t.Fatalf("NewSwapper() returned an error: %v", err)
+	}
+
+	ch, cancel := s.Subscribe()
+	defer cancel()
+
+	select {
+	case got := <-ch:
+		if got != first {
+			t.Errorf("got %v, want seeded current value %v", got, first)
+		}
+	default:
+		t.Fatal("expected the current cached value to be seeded immediately")
+	}
+
+	if err := s.refresh(); err != nil {
+		t.Fatalf("refresh() returned an error: %v", err)
+	}
+
+	select {
+	case got := <-ch:
+		if got != second {
+			t.Errorf("got %v, want rotated value %v", got, second)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a value after refresh rotated credentials")
+	}
+}
+
+func TestSwapperTuneSignerRefusesExpiredWhenFailIfExpired(t *testing.T) {
+	expired := newExpiredTestCreds(t)
+
+	s, err := NewSwapper(func() (*credentials.Credentials, error) { return expired, nil }, WithFailIfExpired(true))
+	if err != nil {
+		t.Fatalf("NewSwapper() returned an error: %v", err)
+	}
+
+	signer := &v4.Signer{}
+	s.tuneSigner(signer)
+
+	if signer.Credentials != nil {
+		t.Error("tuneSigner() set signer.Credentials for expired credentials with WithFailIfExpired(true)")
+	}
+}
+
+func TestSwapperTuneSignerSignsWithValidCredentials(t *testing.T) {
+	valid := newTestCreds(t, time.Now().Add(time.Hour))
+
+	s, err := NewSwapper(func() (*credentials.Credentials, error) { return valid, nil }, WithFailIfExpired(true))
+	if err != nil {
+		t.Fatalf("NewSwapper() returned an error: %v", err)
+	}
+
+	signer := &v4.Signer{}
+	s.tuneSigner(signer)
+
+	if signer.Credentials != valid {
+		t.Error("tuneSigner() did not set signer.Credentials for valid, non-expired credentials")
+	}
+}
@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-kit/log"
+)
+
+// Config represents optional settings.
+type Config struct {
+	logger log.Logger
+	period time.Duration
+
+	adaptive   bool
+	preExpiry  time.Duration
+	jitterFrac float64
+
+	retry          retryPolicy
+	onRefreshError func(err error, attempt int, nextRetry time.Duration)
+}
+
+// retryPolicy configures exponential backoff with full jitter for retrying
+// a failed refresh.
+type retryPolicy struct {
+	maxAttempts  int
+	initial, max time.Duration
+}
+
+// backoff returns a jittered backoff duration for the given attempt (1-based).
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.initial) * math.Pow(2, float64(attempt-1))
+	switch {
+	case d <= 0:
+		d = 0
+	case d > float64(p.max):
+		d = float64(p.max)
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// Option sets up a Config.
+type Option func(*Config)
+
+// WithLogger sets a structured logger.
+func WithLogger(l log.Logger) Option {
+	return func(c *Config) {
+		c.logger = l
+	}
+}
+
+// WithPeriod sets up a period between credentials updates.
+func WithPeriod(p time.Duration) Option {
+	return func(c *Config) {
+		c.period = p
+	}
+}
+
+// WithRetryPolicy makes a failed refresh retry up to maxAttempts times with
+// exponential backoff (doubling from initial, capped at max, with full
+// jitter) instead of waiting a full period before trying again.
+func WithRetryPolicy(maxAttempts int, initial, max time.Duration) Option {
+	return func(c *Config) {
+		c.retry = retryPolicy{
+			maxAttempts: maxAttempts,
+			initial:     initial,
+			max:         max,
+		}
+	}
+}
+
+// WithOnRefreshError registers a callback invoked before each retry of a
+// failed refresh, with the error that triggered it, the retry number
+// (1-based), and how long the retry loop will sleep before that retry.
+// It is not invoked for the last failure once the retries are used up, so
+// without WithRetryPolicy it is never invoked. Useful for wiring alerting.
+func WithOnRefreshError(fn func(err error, attempt int, nextRetry time.Duration)) Option {
+	return func(c *Config) {
+		c.onRefreshError = fn
+	}
+}
+
+// WithAdaptiveRefresh makes the refresh loop sleep until preExpiry before the
+// credentials' reported expiration, instead of on the fixed WithPeriod
+// interval. A random jitter of ±jitter*remaining is added so that many
+// instances refreshing the same credentials don't wake up at the same time.
+//
+// If the underlying credentials don't report a usable expiration (CanExpire
+// is false), the fixed period is used instead.
+func WithAdaptiveRefresh(preExpiry time.Duration, jitter float64) Option {
+	return func(c *Config) {
+		c.adaptive = true
+		c.preExpiry = preExpiry
+		c.jitterFrac = jitter
+	}
+}
+
+// nextInterval returns how long to sleep before the next refresh attempt.
+// In adaptive mode it targets preExpiry before creds expire, with jitter
+// applied; it falls back to the fixed period when adaptive mode is off or
+// creds don't report a usable expiration.
+func (c *Config) nextInterval(creds aws.Credentials) time.Duration {
+	if !c.adaptive || !creds.CanExpire {
+		return c.period
+	}
+
+	remaining := time.Until(creds.Expires) - c.preExpiry
+	if remaining <= 0 {
+		return 0
+	}
+
+	if c.jitterFrac > 0 {
+		jitter := time.Duration((rand.Float64()*2 - 1) * c.jitterFrac * float64(remaining))
+		remaining += jitter
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+	return remaining
+}
+
+// retryRefresh calls fn, and on failure retries it with backoff according to
+// the configured retry policy, logging and invoking onRefreshError along the
+// way. It returns once fn succeeds, the retry policy is exhausted, or ctx is
+// cancelled.
+func (c *Config) retryRefresh(ctx context.Context, fn func() error) {
+	err := fn()
+	if err == nil {
+		return
+	}
+	c.logger.Log("msg", "failed to refresh aws credentials", "err", err)
+
+	for attempt := 1; attempt <= c.retry.maxAttempts; attempt++ {
+		backoff := c.retry.backoff(attempt)
+		if c.onRefreshError != nil {
+			c.onRefreshError(err, attempt, backoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		err = fn()
+		if err == nil {
+			return
+		}
+		c.logger.Log("msg", "failed to refresh aws credentials", "attempt", attempt, "err", err)
+	}
+}
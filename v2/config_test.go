@@ -0,0 +1,157 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-kit/log"
+)
+
+func TestConfigNextIntervalFixedPeriod(t *testing.T) {
+	c := &Config{period: time.Minute}
+	creds := aws.Credentials{}
+
+	if got := c.nextInterval(creds); got != time.Minute {
+		t.Errorf("nextInterval() = %v, want %v", got, time.Minute)
+	}
+}
+
+func TestConfigNextIntervalAdaptiveNoExpiry(t *testing.T) {
+	c := &Config{period: 55 * time.Minute, adaptive: true, preExpiry: 5 * time.Minute}
+	creds := aws.Credentials{}
+
+	if got := c.nextInterval(creds); got != c.period {
+		t.Errorf("nextInterval() = %v, want fallback period %v", got, c.period)
+	}
+}
+
+func TestConfigNextIntervalAdaptive(t *testing.T) {
+	c := &Config{period: time.Minute, adaptive: true, preExpiry: time.Minute}
+	creds := aws.Credentials{CanExpire: true, Expires: time.Now().Add(10 * time.Minute)}
+
+	got := c.nextInterval(creds)
+	want := 9 * time.Minute
+	if got < want-time.Second || got > want+time.Second {
+		t.Errorf("nextInterval() = %v, want ~%v", got, want)
+	}
+}
+
+func TestConfigNextIntervalAdaptiveExpired(t *testing.T) {
+	c := &Config{period: time.Minute, adaptive: true, preExpiry: time.Minute}
+	creds := aws.Credentials{CanExpire: true, Expires: time.Now().Add(-time.Minute)}
+
+	if got := c.nextInterval(creds); got != 0 {
+		t.Errorf("nextInterval() = %v, want 0 for already-expired credentials", got)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       retryPolicy
+		attempt int
+		max     time.Duration
+	}{
+		{"zero initial stays at zero", retryPolicy{initial: 0, max: time.Minute}, 1, 0},
+		{"first attempt bounded by initial", retryPolicy{initial: time.Second, max: time.Minute}, 1, time.Second},
+		{"doubles on second attempt", retryPolicy{initial: time.Second, max: time.Minute}, 2, 2 * time.Second},
+		{"caps at max", retryPolicy{initial: time.Second, max: 5 * time.Second}, 10, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got := tt.p.backoff(tt.attempt)
+				if got < 0 || got > tt.max {
+					t.Fatalf("backoff(%d) = %v, want in [0, %v]", tt.attempt, got, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigRetryRefreshExhaustsAttempts(t *testing.T) {
+	var calls int
+	var onErrAttempts []int
+
+	c := &Config{
+		logger: log.NewNopLogger(),
+		retry: retryPolicy{
+			maxAttempts: 3,
+			initial:     time.Millisecond,
+			max:         time.Millisecond,
+		},
+		onRefreshError: func(err error, attempt int, nextRetry time.Duration) {
+			onErrAttempts = append(onErrAttempts, attempt)
+		},
+	}
+
+	c.retryRefresh(context.Background(), func() error {
+		calls++
+		return errors.New("boom")
+	})
+
+	if want := 1 + c.retry.maxAttempts; calls != want {
+		t.Errorf("calls = %d, want %d (1 initial attempt + %d retries)", calls, want, c.retry.maxAttempts)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(onErrAttempts, want) {
+		t.Errorf("onRefreshError attempts = %v, want %v", onErrAttempts, want)
+	}
+}
+
+func TestConfigRetryRefreshSucceedsWithoutExhausting(t *testing.T) {
+	var calls int
+
+	c := &Config{
+		logger: log.NewNopLogger(),
+		retry:  retryPolicy{maxAttempts: 5, initial: time.Millisecond, max: time.Millisecond},
+	}
+
+	c.retryRefresh(context.Background(), func() error {
+		calls++
+		if calls == 2 {
+			return nil
+		}
+		return errors.New("boom")
+	})
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2 (should stop retrying once fn succeeds)", calls)
+	}
+}
+
+func TestConfigRetryRefreshReturnsOnContextCancel(t *testing.T) {
+	var calls int
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &Config{
+		logger: log.NewNopLogger(),
+		retry:  retryPolicy{maxAttempts: 5, initial: time.Hour, max: time.Hour},
+		onRefreshError: func(err error, attempt int, nextRetry time.Duration) {
+			cancel()
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.retryRefresh(ctx, func() error {
+			calls++
+			return errors.New("boom")
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("retryRefresh did not return promptly after ctx was cancelled")
+	}
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1 (should return during the first backoff sleep)", calls)
+	}
+}
@@ -0,0 +1,98 @@
+// Package v2 aims to tackle AWS SDK tail latency introduced by AWS client
+// calling the STS endpoint, for users of the AWS SDK for Go v2.
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-kit/log"
+)
+
+// NewProvider creates a new Provider wrapping src, and immediately tries to
+// fetch credentials to avoid an initialization penalty in client's API
+// requests.
+//
+// The default refresh period is 55 minutes in case a token expires every hour
+// see https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html.
+func NewProvider(src aws.CredentialsProvider, opts ...Option) (*Provider, error) {
+	if src == nil {
+		return nil, fmt.Errorf("src cannot be nil")
+	}
+
+	p := Provider{
+		conf: Config{
+			logger: log.NewNopLogger(),
+			period: 55 * time.Minute,
+		},
+		src: src,
+	}
+	for _, opt := range opts {
+		opt(&p.conf)
+	}
+
+	if err := p.refresh(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to retrieve credentials value: %w", err)
+	}
+	return &p, nil
+}
+
+// Provider wraps an aws.CredentialsProvider and keeps a background-refreshed
+// cache of its credentials. It satisfies aws.CredentialsProvider itself, so
+// it can be plugged straight into an aws.Config in place of src.
+type Provider struct {
+	conf  Config
+	src   aws.CredentialsProvider
+	cache atomic.Value
+}
+
+// refresh retrieves fresh credentials from src and caches them.
+func (p *Provider) refresh(ctx context.Context) error {
+	creds, err := p.src.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve credentials value: %w", err)
+	}
+
+	p.cache.Store(creds)
+	return nil
+}
+
+// Retrieve returns the cached credentials, satisfying aws.CredentialsProvider.
+// It falls back to src.Retrieve if Run hasn't been started yet and nothing
+// has been cached.
+func (p *Provider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	v := p.cache.Load()
+	creds, ok := v.(aws.Credentials)
+	if !ok {
+		return p.src.Retrieve(ctx)
+	}
+	return creds, nil
+}
+
+// Run periodically refreshes the cached credentials.
+// Note, the call is blocking waiting for context cancellation.
+func (p *Provider) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-time.After(p.nextInterval()):
+			p.conf.retryRefresh(ctx, func() error { return p.refresh(ctx) })
+		}
+	}
+}
+
+// nextInterval returns the sleep duration before the next refresh attempt,
+// based on the currently cached credentials' expiry, see Config.nextInterval.
+func (p *Provider) nextInterval() time.Duration {
+	v := p.cache.Load()
+	creds, ok := v.(aws.Credentials)
+	if !ok {
+		return p.conf.period
+	}
+	return p.conf.nextInterval(creds)
+}
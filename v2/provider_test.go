@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-kit/log"
+)
+
+// fakeProvider is an aws.CredentialsProvider backed by a closure, letting
+// tests control what's returned (and when it fails) on each call.
+type fakeProvider struct {
+	calls    int
+	retrieve func(call int) (aws.Credentials, error)
+}
+
+func (p *fakeProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.calls++
+	return p.retrieve(p.calls)
+}
+
+func TestNewProviderNilSrc(t *testing.T) {
+	if _, err := NewProvider(nil); err == nil {
+		t.Fatal("NewProvider(nil) succeeded, want an error")
+	}
+}
+
+func TestNewProviderReturnsErrorOnFailedInitialFetch(t *testing.T) {
+	src := &fakeProvider{
+		retrieve: func(call int) (aws.Credentials, error) {
+			return aws.Credentials{}, errors.New("sts unavailable")
+		},
+	}
+
+	if _, err := NewProvider(src); err == nil {
+		t.Fatal("NewProvider() succeeded despite a failing initial fetch, want an error")
+	}
+}
+
+func TestProviderRetrieveReturnsCachedCredentials(t *testing.T) {
+	want := aws.Credentials{AccessKeyID: "AKIDTEST"}
+	src := &fakeProvider{
+		retrieve: func(call int) (aws.Credentials, error) { return want, nil },
+	}
+
+	p, err := NewProvider(src)
+	if err != nil {
+		t.Fatalf("NewProvider() returned an error: %v", err)
+	}
+
+	got, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("Retrieve() returned an error: %v", err)
+	}
+	if got != want {
+		t.Errorf("Retrieve() = %v, want cached %v", got, want)
+	}
+}
+
+func TestProviderRetrieveFallsBackToSrcWhenNotCached(t *testing.T) {
+	want := aws.Credentials{AccessKeyID: "AKIDTEST"}
+	src := &fakeProvider{
+		retrieve: func(call int) (aws.Credentials, error) { return want, nil },
+	}
+
+	p := &Provider{conf: Config{logger: log.NewNopLogger()}, src: src}
+
+	got, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("Retrieve() returned an error: %v", err)
+	}
+	if got != want {
+		t.Errorf("Retrieve() = %v, want %v from src", got, want)
+	}
+}
+
+func TestProviderRunRetriesFailedRefresh(t *testing.T) {
+	var onErrAttempts []int
+	src := &fakeProvider{
+		retrieve: func(call int) (aws.Credentials, error) {
+			if call > 1 {
+				return aws.Credentials{}, errors.New("sts unavailable")
+			}
+			return aws.Credentials{AccessKeyID: "AKIDTEST"}, nil
+		},
+	}
+
+	p, err := NewProvider(src,
+		WithPeriod(time.Millisecond),
+		WithRetryPolicy(2, time.Millisecond, time.Millisecond),
+		WithOnRefreshError(func(err error, attempt int, nextRetry time.Duration) {
+			onErrAttempts = append(onErrAttempts, attempt)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewProvider() returned an error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	p.Run(ctx)
+
+	if len(onErrAttempts) == 0 {
+		t.Error("onRefreshError was never invoked, want it to fire while Run retries the failed refresh")
+	}
+}
@@ -0,0 +1,71 @@
+// Package v1adapter bridges a v2 aws.CredentialsProvider into a v1
+// *credentials.Credentials, for mixed codebases that aren't fully off the
+// AWS SDK for Go v1 yet.
+//
+// It's split out from package v2 so that importing v2 for its Provider
+// doesn't drag in aws-sdk-go v1 as a build dependency.
+package v1adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// V1FromV2 adapts a v2 aws.CredentialsProvider into a v1 *credentials.Credentials,
+// so a single background refresher (e.g. v2.Provider) can back clients built
+// against either SDK version, mirroring the v1/v2 interop shim used by
+// hashicorp/aws-sdk-go-base.
+func V1FromV2(p aws.CredentialsProvider) *credentials.Credentials {
+	return credentials.NewCredentials(&v1Provider{src: p})
+}
+
+// v1Provider adapts aws.CredentialsProvider to the v1 credentials.Provider
+// interface expected by credentials.NewCredentials.
+type v1Provider struct {
+	src   aws.CredentialsProvider
+	creds aws.Credentials
+}
+
+// Retrieve fetches credentials from the wrapped v2 provider without a
+// caller-supplied context, see credentials.Provider.
+func (v *v1Provider) Retrieve() (credentials.Value, error) {
+	return v.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext fetches credentials from the wrapped v2 provider,
+// forwarding ctx so cancellation/deadlines reach it, see
+// credentials.ProviderWithContext.
+func (v *v1Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	creds, err := v.src.Retrieve(ctx)
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	v.creds = creds
+
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+// IsExpired reports whether the last retrieved credentials have expired, see credentials.Provider.
+func (v *v1Provider) IsExpired() bool {
+	return v.creds.Expired()
+}
+
+// ExpiresAt returns when the last retrieved credentials expire, satisfying
+// credentials.Expirer so callers can use credentials.Credentials.ExpiresAt
+// (and awscreds' WithAdaptiveRefresh and ExpiresAt, which rely on it) across the
+// v1/v2 boundary. A zero time is returned if the wrapped v2 provider doesn't
+// report an expiration.
+func (v *v1Provider) ExpiresAt() time.Time {
+	if !v.creds.CanExpire {
+		return time.Time{}
+	}
+	return v.creds.Expires
+}